@@ -5,6 +5,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/peterbourgon/ff"
@@ -12,14 +13,26 @@ import (
 	status "go.senan.xyz/compose-status"
 )
 
+// stringsFlag collects the values of a repeatable flag, eg.
+// `-docker-network-name a -docker-network-name b`.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
 var (
-	progName             = "compose-status"
-	progPrefix           = "CS"
-	argSet               = flag.NewFlagSet(progName, flag.ExitOnError)
-	argDockerNetworkName = argSet.String(
-		"docker-network-name", "",
-		"docker network name for status check",
-	)
+	progName   = "compose-status"
+	progPrefix = "CS"
+	argSet     = flag.NewFlagSet(progName, flag.ExitOnError)
+
+	argDockerNetworkNames stringsFlag
+
 	argPageTitle = argSet.String(
 		"page-title", "server status",
 		"title to show at the top of the page (optional)",
@@ -28,16 +41,20 @@ var (
 		"scan-interval", 60,
 		"(in seconds) time to wait between background scans (optional)",
 	)
-	argHistWindow = argSet.Int(
-		"hist-window", 1800,
-		"(in seconds) time window for history graphic (optional)",
-	)
 	argListenAddr = argSet.String(
 		"listen-addr", ":9293",
 		"listen address (optional)",
 	)
+	argEventStream = argSet.Bool(
+		"event-stream", false,
+		"apply updates from the docker event stream instead of polling on the scan interval (optional)",
+	)
 )
 
+func init() {
+	argSet.Var(&argDockerNetworkNames, "docker-network-name", "docker network name for status check, repeatable")
+}
+
 func main() {
 	err := ff.Parse(argSet,
 		os.Args[1:],
@@ -46,14 +63,14 @@ func main() {
 	if err != nil {
 		log.Fatalf("error parsing args: %v\n", err)
 	}
-	if *argDockerNetworkName == "" {
-		log.Fatalln("please provide a docker network name")
+	if len(argDockerNetworkNames) == 0 {
+		log.Fatalln("please provide at least one docker network name")
 	}
 	cont, err := status.NewController(
-		*argDockerNetworkName,
+		[]string(argDockerNetworkNames),
 		status.WithTitle(*argPageTitle),
 		status.WithScanInternal(time.Duration(*argScanInterval)*time.Second),
-		status.WithHistWindow(time.Duration(*argHistWindow)*time.Second),
+		status.WithEventStream(*argEventStream),
 		status.WithCredit,
 	)
 	if err != nil {
@@ -1,7 +1,7 @@
 package status
 
 import (
-	"bytes"
+	"context"
 	_ "embed"
 	"encoding/json"
 	"errors"
@@ -15,11 +15,15 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/docker/docker/api/types/swarm"
 	"github.com/dustin/go-humanize"
 	docker "github.com/fsouza/go-dockerclient"
 	"github.com/oxtoacart/bpool"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/shirou/gopsutil/v3/cpu"
 	"github.com/shirou/gopsutil/v3/host"
@@ -29,7 +33,11 @@ import (
 
 const (
 	exprTempStr = "coretemp_core_[0-9]+"
-	exprHostStr = "Host\\w*(?::|\\(\\`)([0-9a-z\\.-]+)"
+	// matches a traefik Host-family matcher and its arguments, either the v1
+	// colon form (`Host:a.com,b.com;Path=/x`) or the v2/v3 call form
+	// (`HostSNI(`a.com`, `b.com`)`), so parseLabelHost can pull the
+	// arguments out of whichever one it finds.
+	exprHostStr = "(?i)(Host\\w*)\\s*(?:\\(([^)]*)\\)|:([^;\\s]*))"
 	// host prefix & suffix. slightly stupid but needs to support:
 	// traefik v1 `traefik.frontend.rule`
 	// traefik v1 `traefik.<name>.frontend.rule`
@@ -37,19 +45,18 @@ const (
 	labelHostPrefix   = "traefik."
 	labelHostSuffix   = ".rule"
 	labelGroup        = "xyz.senan.compose-status.group"
+	labelCheckType    = "xyz.senan.compose-status.check.type"
 	labelCheckMethod  = "xyz.senan.compose-status.check.method"
 	labelCheckPort    = "xyz.senan.compose-status.check.port"
 	labelCheckPath    = "xyz.senan.compose-status.check.path"
 	labelCheckExpCode = "xyz.senan.compose-status.check.code"
+	labelCheckCmd     = "xyz.senan.compose-status.check.cmd"
 	labelProject      = "com.docker.compose.project"
 )
 
 //go:embed tmpl.html
 var homeTmpl string
 
-//go:embed chart.js
-var chartJS []byte
-
 var (
 	exprTemp = regexp.MustCompile(exprTempStr)
 	exprHost = regexp.MustCompile(exprHostStr)
@@ -76,13 +83,47 @@ var funcMap = template.FuncMap{
 		out, _ := json.Marshal(v)
 		return template.JS(out)
 	},
+	"lastProbes": func(n int, probes []HealthProbe) []HealthProbe {
+		if len(probes) <= n {
+			return probes
+		}
+		return probes[len(probes)-n:]
+	},
 }
 
 type Container struct {
-	Name   string
-	Status string
-	Link   string
-	HTTP   HTTPCheck
+	Name     string
+	Status   string
+	Link     string
+	AltLinks []string
+	Check    Check
+	Health   *Health
+}
+
+// Check is the result of probing a single container for liveness, produced
+// by whichever checker the xyz.senan.compose-status.check.type label
+// selects. It lets the template and the metrics endpoint render any probe
+// type uniformly.
+type Check interface {
+	OK() bool
+	Latency() time.Duration
+	Detail() string
+}
+
+// Health mirrors the state Docker tracks for containers with a HEALTHCHECK
+// declared in their Dockerfile or compose service.
+type Health struct {
+	Status        string
+	FailingStreak int
+	Latency       time.Duration
+	Log           []HealthProbe
+}
+
+type HealthProbe struct {
+	ExitCode int
+	Output   string
+	Start    time.Time
+	End      time.Time
 }
 
 type Stats struct {
@@ -96,31 +137,37 @@ type Stats struct {
 	Uptime   time.Duration
 }
 
-type hist []float64
-
-func (h *hist) add(n float64) {
-	*h = append(*h, n)
-	*h = (*h)[1:len(*h)]
-}
-
 type Controller struct {
-	tmpl              *template.Template
-	dockerNetworkName string
-	dockerClient      *docker.Client
-	httpClient        *http.Client
-	buffPool          *bpool.BufferPool
-	scanInterval      time.Duration
-	pageTitle         string
-	showCredit        bool
-	lastGroups        map[string][]string
-	lastProjects      map[string][]Container
-	lastStats         Stats
-	histCPU           hist
-	histTemp          hist
+	tmpl               *template.Template
+	dockerNetworkNames []string
+	dockerClient       *docker.Client
+	httpClient         *http.Client
+	buffPool           *bpool.BufferPool
+	scanInterval       time.Duration
+	pageTitle          string
+	showCredit         bool
+	eventStream        bool
+
+	mu             sync.Mutex
+	lastGroups     map[string][]string
+	lastProjects   map[string][]Container
+	lastStats      Stats
+	lastNetworkIDs []string
+	lastRaw        map[string]rawContainer
+	sseClients     map[chan sseEvent]struct{}
 
 	*http.ServeMux
 }
 
+// rawContainer is the docker-reported state a container update was built
+// from. It's kept around so the event-driven ticker can re-run the HTTP and
+// health checks without re-listing every container on the daemon.
+type rawContainer struct {
+	project string
+	group   string
+	dc      docker.APIContainers
+}
+
 type ControllerOpt func(*Controller) error
 
 func WithTitle(title string) ControllerOpt {
@@ -137,20 +184,23 @@ func WithScanInternal(dur time.Duration) ControllerOpt {
 	}
 }
 
-func WithHistWindow(dur time.Duration) ControllerOpt {
-	return func(c *Controller) error {
-		c.histCPU = hist(make([]float64, dur/c.scanInterval))
-		c.histTemp = hist(make([]float64, dur/c.scanInterval))
-		return nil
-	}
-}
-
 func WithCredit(c *Controller) error {
 	c.showCredit = true
 	return nil
 }
 
-func NewController(dockerNetworkName string, options ...ControllerOpt) (*Controller, error) {
+// WithEventStream switches the controller from polling the Docker daemon on
+// a fixed interval to applying incremental updates from the daemon's event
+// stream, falling back to the scan interval only for host stats and
+// re-running existing checks.
+func WithEventStream(enabled bool) ControllerOpt {
+	return func(c *Controller) error {
+		c.eventStream = enabled
+		return nil
+	}
+}
+
+func NewController(dockerNetworkNames []string, options ...ControllerOpt) (*Controller, error) {
 	dockerClient, err := docker.NewClientFromEnv()
 	if err != nil {
 		return nil, fmt.Errorf("creating docker client: %w", err)
@@ -163,9 +213,9 @@ func NewController(dockerNetworkName string, options ...ControllerOpt) (*Control
 		return nil, fmt.Errorf("parsing template: %w", err)
 	}
 	cont := &Controller{
-		tmpl:              tmpl,
-		dockerClient:      dockerClient,
-		dockerNetworkName: dockerNetworkName,
+		tmpl:               tmpl,
+		dockerClient:       dockerClient,
+		dockerNetworkNames: dockerNetworkNames,
 		httpClient: &http.Client{
 			Timeout: 25 * time.Millisecond,
 			CheckRedirect: func(req *http.Request, via []*http.Request) error {
@@ -181,20 +231,47 @@ func NewController(dockerNetworkName string, options ...ControllerOpt) (*Control
 			return nil, fmt.Errorf("running option: %w", err)
 		}
 	}
-	cont.ServeMux.HandleFunc("/chart.js", cont.serveChartJS)
+	cont.ServeMux.HandleFunc("/metrics", cont.serveMetrics)
+	cont.ServeMux.HandleFunc("/events", cont.serveEvents)
 	cont.ServeMux.HandleFunc("/", cont.serveHome)
 	return cont, nil
 }
 
-func parseLabelHost(label string) string {
-	match := exprHost.FindStringSubmatch(label)
-	if len(match) < 2 {
-		return ""
+// parseLabelHost tokenizes a traefik routing rule label and returns the
+// preferred host to link to, plus any other hosts the rule also matches on
+// (eg. a multi-argument `Host(`a.com`, `b.com`)`, or several Host-family
+// matchers combined with `&&`/`||`). HostRegexp matchers contribute no host,
+// since their argument is a regular expression rather than a literal one.
+func parseLabelHost(label string) (string, []string) {
+	var hosts []string
+	for _, match := range exprHost.FindAllStringSubmatch(label, -1) {
+		name, paren, colon := match[1], match[2], match[3]
+		if strings.EqualFold(name, "HostRegexp") {
+			continue
+		}
+		var args string
+		if paren != "" {
+			args = paren
+		} else {
+			args = colon
+		}
+		for _, arg := range strings.Split(args, ",") {
+			arg = strings.Trim(strings.TrimSpace(arg), "`")
+			if arg != "" {
+				hosts = append(hosts, arg)
+			}
+		}
+	}
+	if len(hosts) == 0 {
+		return "", nil
+	}
+	if len(hosts) == 1 {
+		return hosts[0], nil
 	}
-	return match[1]
+	return hosts[0], hosts[1:]
 }
 
-func parseLabelsLink(labels map[string]string) string {
+func parseLabelsLink(labels map[string]string) (string, []string) {
 	for k, v := range labels {
 		prefix := strings.HasPrefix(k, labelHostPrefix)
 		suffix := strings.HasSuffix(k, labelHostSuffix)
@@ -202,7 +279,7 @@ func parseLabelsLink(labels map[string]string) string {
 			return parseLabelHost(v)
 		}
 	}
-	return ""
+	return "", nil
 }
 
 func parseStatus(status string) string {
@@ -212,14 +289,36 @@ func parseStatus(status string) string {
 	return status
 }
 
+// containerIP returns a container's IP address on any one of the given
+// docker networks, or "" if it isn't attached to any of them.
+func containerIP(dockerNetworkIDs []string, dockerContainer docker.APIContainers) string {
+	for _, v := range dockerContainer.Networks.Networks {
+		if containsStr(dockerNetworkIDs, v.NetworkID) {
+			return v.IPAddress
+		}
+	}
+	return ""
+}
+
+// HTTPCheck is the result of an HTTP liveness probe
+// (xyz.senan.compose-status.check.type=http, the default).
 type HTTPCheck struct {
-	OK       bool
-	Code     int
-	Duration time.Duration
-	Timeout  bool
+	ok       bool
+	code     int
+	duration time.Duration
+	timeout  bool
+}
+
+func (h *HTTPCheck) OK() bool               { return h.ok }
+func (h *HTTPCheck) Latency() time.Duration { return h.duration }
+func (h *HTTPCheck) Detail() string {
+	if h.timeout {
+		return "timeout"
+	}
+	return fmt.Sprintf("http %d", h.code)
 }
 
-func checkHTTP(httpClient *http.Client, dockerNetworkID string, dockerContainer docker.APIContainers) (*HTTPCheck, error) {
+func checkHTTP(httpClient *http.Client, dockerNetworkIDs []string, dockerContainer docker.APIContainers) (Check, error) {
 	portRaw, ok := dockerContainer.Labels[labelCheckPort]
 	if !ok {
 		return nil, nil
@@ -238,14 +337,7 @@ func checkHTTP(httpClient *http.Client, dockerNetworkID string, dockerContainer
 	if c, ok := dockerContainer.Labels[labelCheckExpCode]; ok {
 		expCode, _ = strconv.Atoi(c)
 	}
-	var ip string
-	for _, v := range dockerContainer.Networks.Networks {
-		if v.NetworkID != dockerNetworkID {
-			continue
-		}
-		ip = v.IPAddress
-		break
-	}
+	ip := containerIP(dockerNetworkIDs, dockerContainer)
 	if ip == "" {
 		return nil, nil
 	}
@@ -261,19 +353,220 @@ func checkHTTP(httpClient *http.Client, dockerNetworkID string, dockerContainer
 	res, err := httpClient.Do(req)
 	var netErr net.Error
 	if errors.As(err, &netErr) && netErr.Timeout() {
-		return &HTTPCheck{Timeout: true}, nil
+		return &HTTPCheck{timeout: true}, nil
 	}
 	if err != nil {
 		return nil, fmt.Errorf("make request: %w", err)
 	}
 	check := &HTTPCheck{
-		Code:     res.StatusCode,
-		Duration: time.Since(start),
+		code:     res.StatusCode,
+		duration: time.Since(start),
 	}
 	if (res.StatusCode >= 200 && res.StatusCode < 300) || res.StatusCode == expCode {
-		check.OK = true
+		check.ok = true
 	}
-	return check, err
+	return check, nil
+}
+
+// TCPCheck is the result of a TCP dial liveness probe
+// (xyz.senan.compose-status.check.type=tcp).
+type TCPCheck struct {
+	ok       bool
+	duration time.Duration
+}
+
+func (t *TCPCheck) OK() bool               { return t.ok }
+func (t *TCPCheck) Latency() time.Duration { return t.duration }
+func (t *TCPCheck) Detail() string {
+	if t.ok {
+		return "tcp open"
+	}
+	return "tcp closed"
+}
+
+func checkTCP(dockerNetworkIDs []string, dockerContainer docker.APIContainers) (Check, error) {
+	portRaw, ok := dockerContainer.Labels[labelCheckPort]
+	if !ok {
+		return nil, nil
+	}
+	ip := containerIP(dockerNetworkIDs, dockerContainer)
+	if ip == "" {
+		return nil, nil
+	}
+	addr := net.JoinHostPort(ip, portRaw)
+
+	start := time.Now()
+	conn, err := net.DialTimeout("tcp", addr, 25*time.Millisecond)
+	if err != nil {
+		return &TCPCheck{}, nil
+	}
+	defer conn.Close()
+	return &TCPCheck{ok: true, duration: time.Since(start)}, nil
+}
+
+// GRPCCheck is the result of a grpc.health.v1.Health/Check liveness probe
+// (xyz.senan.compose-status.check.type=grpc).
+type GRPCCheck struct {
+	ok       bool
+	status   string
+	duration time.Duration
+}
+
+func (g *GRPCCheck) OK() bool               { return g.ok }
+func (g *GRPCCheck) Latency() time.Duration { return g.duration }
+func (g *GRPCCheck) Detail() string         { return g.status }
+
+func checkGRPC(dockerNetworkIDs []string, dockerContainer docker.APIContainers) (Check, error) {
+	portRaw, ok := dockerContainer.Labels[labelCheckPort]
+	if !ok {
+		return nil, nil
+	}
+	ip := containerIP(dockerNetworkIDs, dockerContainer)
+	if ip == "" {
+		return nil, nil
+	}
+	addr := net.JoinHostPort(ip, portRaw)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 25*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return &GRPCCheck{status: err.Error()}, nil
+	}
+	defer conn.Close()
+
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		return &GRPCCheck{status: err.Error(), duration: time.Since(start)}, nil
+	}
+	return &GRPCCheck{
+		ok:       resp.Status == grpc_health_v1.HealthCheckResponse_SERVING,
+		status:   resp.Status.String(),
+		duration: time.Since(start),
+	}, nil
+}
+
+// execCheckTimeout bounds how long checkExec waits for the probe command to
+// finish. It's far looser than the 25ms network checks since it's running an
+// arbitrary command rather than dialing a socket, but still needs a bound so
+// a hung xyz.senan.compose-status.check.cmd can't stall every other
+// container's check, which run one at a time on the same goroutine.
+const execCheckTimeout = 2 * time.Second
+
+// execCheckPollInterval is how often checkExec polls InspectExec while
+// waiting for the probe command to finish.
+const execCheckPollInterval = 25 * time.Millisecond
+
+// ExecCheck is the result of a `docker exec` liveness probe
+// (xyz.senan.compose-status.check.type=exec), treating exit code 0 as
+// healthy.
+type ExecCheck struct {
+	ok       bool
+	exitCode int
+	timedOut bool
+	duration time.Duration
+}
+
+func (e *ExecCheck) OK() bool               { return e.ok }
+func (e *ExecCheck) Latency() time.Duration { return e.duration }
+func (e *ExecCheck) Detail() string {
+	if e.timedOut {
+		return "exec timeout"
+	}
+	return fmt.Sprintf("exec exit %d", e.exitCode)
+}
+
+func (c *Controller) checkExec(dockerContainer docker.APIContainers) (Check, error) {
+	cmdRaw, ok := dockerContainer.Labels[labelCheckCmd]
+	if !ok {
+		return nil, nil
+	}
+	cmd := strings.Fields(cmdRaw)
+	if len(cmd) == 0 {
+		return nil, nil
+	}
+
+	start := time.Now()
+	exec, err := c.dockerClient.CreateExec(docker.CreateExecOptions{
+		Container: dockerContainer.ID,
+		Cmd:       cmd,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create exec: %w", err)
+	}
+	// start detached so we're not left blocking on the exec's attach stream
+	// if the command runs long; we bound our own wait below by polling
+	// InspectExec instead.
+	if err := c.dockerClient.StartExec(exec.ID, docker.StartExecOptions{Detach: true}); err != nil {
+		return nil, fmt.Errorf("start exec: %w", err)
+	}
+	deadline := start.Add(execCheckTimeout)
+	for {
+		inspect, err := c.dockerClient.InspectExec(exec.ID)
+		if err != nil {
+			return nil, fmt.Errorf("inspect exec: %w", err)
+		}
+		if !inspect.Running {
+			return &ExecCheck{
+				ok:       inspect.ExitCode == 0,
+				exitCode: inspect.ExitCode,
+				duration: time.Since(start),
+			}, nil
+		}
+		if time.Now().After(deadline) {
+			return &ExecCheck{timedOut: true, duration: time.Since(start)}, nil
+		}
+		time.Sleep(execCheckPollInterval)
+	}
+}
+
+// runCheck runs the liveness probe selected by a container's
+// xyz.senan.compose-status.check.type label, defaulting to the HTTP check
+// for backwards compatibility with labels set before the other probe types
+// existed.
+func (c *Controller) runCheck(dockerNetworkIDs []string, dockerContainer docker.APIContainers) (Check, error) {
+	switch dockerContainer.Labels[labelCheckType] {
+	case "tcp":
+		return checkTCP(dockerNetworkIDs, dockerContainer)
+	case "grpc":
+		return checkGRPC(dockerNetworkIDs, dockerContainer)
+	case "exec":
+		return c.checkExec(dockerContainer)
+	default:
+		return checkHTTP(c.httpClient, dockerNetworkIDs, dockerContainer)
+	}
+}
+
+// checkHealth reads the native Docker HEALTHCHECK state for a container, if
+// it declares one. It returns nil, nil when the container has no
+// HEALTHCHECK configured, so callers can fall back to a synthetic check.
+func checkHealth(dockerClient *docker.Client, containerID string) (*Health, error) {
+	inspect, err := dockerClient.InspectContainerWithOptions(docker.InspectContainerOptions{ID: containerID})
+	if err != nil {
+		return nil, fmt.Errorf("inspect container: %w", err)
+	}
+	dockerHealth := inspect.State.Health
+	if dockerHealth.Status == "" {
+		return nil, nil
+	}
+	health := &Health{
+		Status:        dockerHealth.Status,
+		FailingStreak: dockerHealth.FailingStreak,
+	}
+	for _, probe := range dockerHealth.Log {
+		health.Log = append(health.Log, HealthProbe{
+			ExitCode: probe.ExitCode,
+			Output:   probe.Output,
+			Start:    probe.Start,
+			End:      probe.End,
+		})
+	}
+	if n := len(health.Log); n > 0 {
+		health.Latency = health.Log[n-1].End.Sub(health.Log[n-1].Start)
+	}
+	return health, nil
 }
 
 func averageTemp(cores []host.TemperatureStat) float64 {
@@ -291,20 +584,246 @@ func averageTemp(cores []host.TemperatureStat) float64 {
 	return temp / float64(numCores)
 }
 
-func (c *Controller) Refresh() error {
+// resolveNetworkIDs looks up the IDs of the configured docker networks and
+// caches them, so recheckContainers can reuse them without re-listing
+// networks on every tick. Container events still re-resolve them each time.
+func (c *Controller) resolveNetworkIDs() ([]string, error) {
 	dockerNetworks, err := c.dockerClient.ListNetworks()
 	if err != nil {
-		return fmt.Errorf("list docker networks: %w", err)
+		return nil, fmt.Errorf("list docker networks: %w", err)
 	}
-	var dockerNetworkID string
+	byName := map[string]string{}
 	for _, dn := range dockerNetworks {
-		if dn.Name == c.dockerNetworkName {
-			dockerNetworkID = dn.ID
-			break
+		byName[dn.Name] = dn.ID
+	}
+	ids := make([]string, 0, len(c.dockerNetworkNames))
+	for _, name := range c.dockerNetworkNames {
+		id, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("can't find docker network %q", name)
 		}
+		ids = append(ids, id)
+	}
+	c.mu.Lock()
+	c.lastNetworkIDs = ids
+	c.mu.Unlock()
+	return ids, nil
+}
+
+// containsStr reports whether s is present in list.
+func containsStr(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// cloneGroups deep-copies a groups map, including its slices, so the result
+// can be read (and, as serveHome does, sorted in place) without holding the
+// controller's mutex. A shallow copy isn't enough: upsertContainer and
+// removeContainer mutate the live slices in place, including via append,
+// which can write into the same backing array a shallow copy's slice header
+// still points at.
+func cloneGroups(m map[string][]string) map[string][]string {
+	out := make(map[string][]string, len(m))
+	for k, v := range m {
+		cp := make([]string, len(v))
+		copy(cp, v)
+		out[k] = cp
+	}
+	return out
+}
+
+// cloneProjects deep-copies a projects map the same way cloneGroups does,
+// for the same reason.
+func cloneProjects(m map[string][]Container) map[string][]Container {
+	out := make(map[string][]Container, len(m))
+	for k, v := range m {
+		cp := make([]Container, len(v))
+		copy(cp, v)
+		out[k] = cp
+	}
+	return out
+}
+
+// containerUpdate is the result of checking a single docker container,
+// ready to be merged into the controller's last known state.
+type containerUpdate struct {
+	project   string
+	group     string
+	container Container
+	raw       rawContainer
+}
+
+// buildContainer runs the health/HTTP checks for a single docker container
+// and returns the update to merge into state. It returns a nil update for
+// containers not managed by compose.
+func (c *Controller) buildContainer(dockerNetworkIDs []string, dockerContainer docker.APIContainers) (*containerUpdate, error) {
+	if len(dockerContainer.Names) == 0 {
+		return nil, fmt.Errorf("%q does not have a name", dockerContainer.ID)
+	}
+	project, ok := dockerContainer.Labels[labelProject]
+	if !ok {
+		return nil, nil
+	}
+	link, altLinks := parseLabelsLink(dockerContainer.Labels)
+	container := Container{
+		Name:     dockerContainer.Names[0],
+		Status:   parseStatus(dockerContainer.Status),
+		Link:     link,
+		AltLinks: altLinks,
+	}
+	health, err := checkHealth(c.dockerClient, dockerContainer.ID)
+	if err != nil {
+		log.Printf("error getting health for %q: %v\n", container.Name, err)
+	}
+	if health != nil {
+		container.Health = health
+	} else {
+		check, err := c.runCheck(dockerNetworkIDs, dockerContainer)
+		if err != nil {
+			log.Printf("error getting check for %q: %v\n", container.Name, err)
+		}
+		if check != nil {
+			container.Check = check
+		}
+	}
+	group := dockerContainer.Labels[labelGroup]
+	return &containerUpdate{
+		project:   project,
+		group:     group,
+		container: container,
+		raw:       rawContainer{project: project, group: group, dc: dockerContainer},
+	}, nil
+}
+
+// sseEvent is a single message sent to subscribed browsers over
+// ServeEvents, encoded as JSON in the "data" field of a Server-Sent Event.
+// Type selects how the client-side script should patch the DOM.
+type sseEvent struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+}
+
+// subscribeSSE registers a new /events client and returns a channel it
+// should read published events from, and a function to unregister it once
+// the connection closes.
+func (c *Controller) subscribeSSE() (chan sseEvent, func()) {
+	ch := make(chan sseEvent, 16)
+	c.mu.Lock()
+	if c.sseClients == nil {
+		c.sseClients = map[chan sseEvent]struct{}{}
+	}
+	c.sseClients[ch] = struct{}{}
+	c.mu.Unlock()
+	return ch, func() {
+		c.mu.Lock()
+		delete(c.sseClients, ch)
+		c.mu.Unlock()
+	}
+}
+
+// publishSSE sends an event to every subscribed /events client, dropping it
+// for any client whose buffer is full rather than blocking the caller.
+func (c *Controller) publishSSE(ev sseEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for ch := range c.sseClients {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+// upsertContainer merges a single container update into the last known
+// state under the controller's mutex, for use by both the full scan and the
+// incremental, event-driven updates.
+func (c *Controller) upsertContainer(id string, update *containerUpdate) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.lastProjects == nil {
+		c.lastProjects = map[string][]Container{}
 	}
-	if dockerNetworkID == "" {
-		return fmt.Errorf("can't find docker network %q", c.dockerNetworkName)
+	if c.lastGroups == nil {
+		c.lastGroups = map[string][]string{}
+	}
+	if c.lastRaw == nil {
+		c.lastRaw = map[string]rawContainer{}
+	}
+	c.lastRaw[id] = update.raw
+	containers := c.lastProjects[update.project]
+	for i, existing := range containers {
+		if existing.Name == update.container.Name {
+			containers[i] = update.container
+			c.lastProjects[update.project] = containers
+			return
+		}
+	}
+	c.lastProjects[update.project] = append(containers, update.container)
+	group := update.group
+	if group == "" {
+		group = "~"
+	}
+	for _, p := range c.lastGroups[group] {
+		if p == update.project {
+			return
+		}
+	}
+	c.lastGroups[group] = append(c.lastGroups[group], update.project)
+}
+
+// removeContainer drops a container, identified by its daemon ID, from the
+// last known state, for use after a "destroy" event. It reports the project
+// and name the container was removed from, so the caller can publish an SSE
+// event, and false if the ID wasn't known.
+func (c *Controller) removeContainer(id string) (project, name string, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	raw, ok := c.lastRaw[id]
+	if !ok {
+		return "", "", false
+	}
+	delete(c.lastRaw, id)
+	if len(raw.dc.Names) > 0 {
+		name = raw.dc.Names[0]
+	}
+	containers := c.lastProjects[raw.project]
+	for i, existing := range containers {
+		if existing.Name != name {
+			continue
+		}
+		containers = append(containers[:i], containers[i+1:]...)
+		break
+	}
+	if len(containers) > 0 {
+		c.lastProjects[raw.project] = containers
+		return raw.project, name, true
+	}
+	delete(c.lastProjects, raw.project)
+	group := raw.group
+	if group == "" {
+		group = "~"
+	}
+	projects := c.lastGroups[group]
+	for i, p := range projects {
+		if p != raw.project {
+			continue
+		}
+		c.lastGroups[group] = append(projects[:i], projects[i+1:]...)
+		break
+	}
+	return raw.project, name, true
+}
+
+// Refresh re-lists every network and container on the daemon and rebuilds
+// the controller's state from scratch, including host stats.
+func (c *Controller) Refresh() error {
+	dockerNetworkIDs, err := c.resolveNetworkIDs()
+	if err != nil {
+		return err
 	}
 	dockerContainers, err := c.dockerClient.ListContainers(
 		docker.ListContainersOptions{},
@@ -312,43 +831,118 @@ func (c *Controller) Refresh() error {
 	if err != nil {
 		return fmt.Errorf("listing containers: %w", err)
 	}
-	c.lastGroups = map[string][]string{}
-	c.lastProjects = map[string][]Container{}
+	groups := map[string][]string{}
+	projects := map[string][]Container{}
+	raw := map[string]rawContainer{}
 	groupedProjects := map[string]struct{}{}
-	// insert the current time for any container we see
 	for _, dockerContainer := range dockerContainers {
-		if len(dockerContainer.Names) == 0 {
-			return fmt.Errorf("%q does not have a name", dockerContainer.ID)
+		update, err := c.buildContainer(dockerNetworkIDs, dockerContainer)
+		if err != nil {
+			return err
 		}
-		project, ok := dockerContainer.Labels[labelProject]
-		if !ok {
+		if update == nil {
 			continue
 		}
-		if group, ok := dockerContainer.Labels[labelGroup]; ok {
-			c.lastGroups[group] = append(c.lastGroups[group], project)
-			groupedProjects[project] = struct{}{}
-		}
-		container := Container{
-			Name:   dockerContainer.Names[0],
-			Status: parseStatus(dockerContainer.Status),
-			Link:   parseLabelsLink(dockerContainer.Labels),
-		}
-		check, err := checkHTTP(c.httpClient, dockerNetworkID, dockerContainer)
-		if err != nil {
-			log.Printf("error getting http check for %q: %v\n", container.Name, err)
-		}
-		if check != nil {
-			container.HTTP = *check
+		if update.group != "" {
+			groups[update.group] = append(groups[update.group], update.project)
+			groupedProjects[update.project] = struct{}{}
 		}
-		c.lastProjects[project] = append(c.lastProjects[project], container)
+		projects[update.project] = append(projects[update.project], update.container)
+		raw[dockerContainer.ID] = update.raw
+	}
+	if err := c.scanSwarmServices(groups, projects, groupedProjects); err != nil {
+		log.Printf("error scanning swarm services: %v\n", err)
 	}
-	for project := range c.lastProjects {
+	for project := range projects {
 		if _, ok := groupedProjects[project]; !ok {
 			// put the ungrouped projects into the "~" pseudo group
-			c.lastGroups["~"] = append(c.lastGroups["~"], project)
+			groups["~"] = append(groups["~"], project)
+		}
+	}
+
+	c.mu.Lock()
+	c.lastGroups = groups
+	c.lastProjects = projects
+	c.lastRaw = raw
+	// built while still holding the lock: groups/projects (now ==
+	// c.lastGroups/c.lastProjects) are live state that
+	// recheckContainers/handleContainerEvent can mutate from another
+	// goroutine the moment we unlock, so they can't be read afterwards
+	// without racing them.
+	groupsSnapshot := cloneGroups(groups)
+	projectsJSON := make(map[string][]containerJSON, len(projects))
+	for project, cs := range projects {
+		list := make([]containerJSON, len(cs))
+		for i, ct := range cs {
+			list[i] = newContainerJSON(ct)
 		}
+		projectsJSON[project] = list
 	}
+	c.mu.Unlock()
+
+	c.publishSSE(sseEvent{Type: "snapshot", Data: struct {
+		Groups   map[string][]string        `json:"groups"`
+		Projects map[string][]containerJSON `json:"projects"`
+	}{groupsSnapshot, projectsJSON}})
 
+	return c.refreshStats()
+}
+
+// scanSwarmServices lists Swarm services and their tasks, if the daemon is
+// an active member of a swarm, and groups tasks by service name into
+// projects/groups so replicated services show up with their replica count
+// and per-task status alongside the regular compose-label containers. It's
+// a no-op, without error, on a daemon that isn't in swarm mode.
+func (c *Controller) scanSwarmServices(groups map[string][]string, projects map[string][]Container, groupedProjects map[string]struct{}) error {
+	info, err := c.dockerClient.Info()
+	if err != nil {
+		return fmt.Errorf("docker info: %w", err)
+	}
+	if info.Swarm.LocalNodeState != swarm.LocalNodeStateActive {
+		return nil
+	}
+	services, err := c.dockerClient.ListServices(docker.ListServicesOptions{})
+	if err != nil {
+		return fmt.Errorf("list services: %w", err)
+	}
+	tasks, err := c.dockerClient.ListTasks(docker.ListTasksOptions{})
+	if err != nil {
+		return fmt.Errorf("list tasks: %w", err)
+	}
+	tasksByService := map[string][]swarm.Task{}
+	for _, task := range tasks {
+		tasksByService[task.ServiceID] = append(tasksByService[task.ServiceID], task)
+	}
+	for _, service := range services {
+		project := service.Spec.Annotations.Name
+		link, altLinks := parseLabelsLink(service.Spec.Annotations.Labels)
+		for _, task := range tasksByService[service.ID] {
+			status := string(task.Status.State)
+			if task.Status.State == swarm.TaskStateRunning {
+				status = "up"
+			}
+			projects[project] = append(projects[project], Container{
+				Name:     fmt.Sprintf("%s.%d", project, task.Slot),
+				Status:   status,
+				Link:     link,
+				AltLinks: altLinks,
+			})
+		}
+		group := service.Spec.Annotations.Labels[labelGroup]
+		if group == "" {
+			continue
+		}
+		groups[group] = append(groups[group], project)
+		groupedProjects[project] = struct{}{}
+	}
+	return nil
+}
+
+// refreshStats re-reads the host cpu/mem/load/temp/uptime figures. It's
+// cheap enough to run on every tick, even in event-driven mode where
+// container state is otherwise kept up to date incrementally.
+func (c *Controller) refreshStats() error {
+	c.mu.Lock()
 	// not checking errors here becuase some of these return lists of
 	// warnings which i don't care about at the moment
 	if uptime, _ := host.Uptime(); uptime != 0 {
@@ -364,25 +958,162 @@ func (c *Controller) Refresh() error {
 		c.lastStats.MemTotal = memory.Total
 	}
 	if cpus, _ := cpu.Percent(0, false); len(cpus) > 0 {
-		round := math.Round(cpus[0]*100) / 100
-		c.lastStats.CPU = round
-		c.histCPU.add(round)
+		c.lastStats.CPU = math.Round(cpus[0]*100) / 100
 	}
 	if temps, _ := host.SensorsTemperatures(); len(temps) > 0 {
-		avg := averageTemp(temps)
-		c.lastStats.CPUTemp = avg
-		c.histTemp.add(avg)
+		c.lastStats.CPUTemp = averageTemp(temps)
 	}
+	stats := c.lastStats
+	c.mu.Unlock()
+
+	c.publishSSE(sseEvent{Type: "stats", Data: stats})
 	return nil
 }
 
+// recheckContainers re-runs the health/HTTP checks for every container seen
+// so far, without re-listing containers or networks. It's the ticker's job
+// in event-driven mode, where container comings and goings are otherwise
+// handled as they're reported by the daemon.
+func (c *Controller) recheckContainers() {
+	c.mu.Lock()
+	dockerNetworkIDs := c.lastNetworkIDs
+	raws := make(map[string]rawContainer, len(c.lastRaw))
+	for id, raw := range c.lastRaw {
+		raws[id] = raw
+	}
+	c.mu.Unlock()
+
+	for id, raw := range raws {
+		update, err := c.buildContainer(dockerNetworkIDs, raw.dc)
+		if err != nil {
+			log.Printf("error rechecking container %q: %v\n", id, err)
+			continue
+		}
+		if update == nil {
+			continue
+		}
+		c.upsertContainer(id, update)
+		c.publishContainerUpsert(update)
+	}
+}
+
+// watchEvents subscribes to the docker event stream and applies incremental
+// updates as containers and networks change, reconnecting with a backoff if
+// the daemon socket drops.
+func (c *Controller) watchEvents() {
+	backoff := time.Second
+	for {
+		listener := make(chan *docker.APIEvents, 32)
+		err := c.dockerClient.AddEventListenerWithOptions(docker.EventsOptions{
+			Filters: map[string][]string{
+				"type":  {"container", "network"},
+				"event": {"start", "die", "health_status", "destroy", "rename"},
+			},
+		}, listener)
+		if err != nil {
+			log.Printf("error subscribing to docker events: %v\n", err)
+			time.Sleep(backoff)
+			backoff = nextBackoff(backoff)
+			continue
+		}
+		backoff = time.Second
+
+		for ev := range listener {
+			c.handleEvent(ev)
+		}
+
+		log.Println("docker event stream closed, reconnecting")
+		_ = c.dockerClient.RemoveEventListener(listener)
+		time.Sleep(backoff)
+		backoff = nextBackoff(backoff)
+	}
+}
+
+func nextBackoff(cur time.Duration) time.Duration {
+	if next := cur * 2; next <= 30*time.Second {
+		return next
+	}
+	return 30 * time.Second
+}
+
+func (c *Controller) handleEvent(ev *docker.APIEvents) {
+	switch ev.Type {
+	case "network":
+		// the event filter above only lets network "destroy" through (not
+		// "connect"/"disconnect"); rather than work out which containers it
+		// affected, just re-scan everything
+		if err := c.Refresh(); err != nil {
+			log.Printf("error refreshing after network event: %v\n", err)
+		}
+	case "container":
+		c.handleContainerEvent(ev)
+	}
+}
+
+func (c *Controller) handleContainerEvent(ev *docker.APIEvents) {
+	if ev.Action == "destroy" {
+		if project, name, ok := c.removeContainer(ev.Actor.ID); ok {
+			c.publishSSE(sseEvent{Type: "remove", Data: struct {
+				Project string `json:"project"`
+				Name    string `json:"name"`
+			}{project, name}})
+		}
+		return
+	}
+	dockerNetworkIDs, err := c.resolveNetworkIDs()
+	if err != nil {
+		log.Printf("error resolving docker networks after %q event: %v\n", ev.Action, err)
+		return
+	}
+	dockerContainers, err := c.dockerClient.ListContainers(docker.ListContainersOptions{
+		All:     true,
+		Filters: map[string][]string{"id": {ev.Actor.ID}},
+	})
+	if err != nil {
+		log.Printf("error listing container %q after %q event: %v\n", ev.Actor.ID, ev.Action, err)
+		return
+	}
+	if len(dockerContainers) == 0 {
+		return
+	}
+	update, err := c.buildContainer(dockerNetworkIDs, dockerContainers[0])
+	if err != nil {
+		log.Printf("error building container %q after %q event: %v\n", ev.Actor.ID, ev.Action, err)
+		return
+	}
+	if update == nil {
+		return
+	}
+	c.upsertContainer(ev.Actor.ID, update)
+	c.publishContainerUpsert(update)
+}
+
+// publishContainerUpsert announces a container add/update to subscribed
+// /events clients.
+func (c *Controller) publishContainerUpsert(update *containerUpdate) {
+	c.publishSSE(sseEvent{Type: "container", Data: struct {
+		Project   string        `json:"project"`
+		Container containerJSON `json:"container"`
+	}{update.project, newContainerJSON(update.container)}})
+}
+
 func (c *Controller) Start() {
 	if err := c.Refresh(); err != nil {
 		log.Printf("error refreshing: %v\n", err)
 	}
+	if c.eventStream {
+		go c.watchEvents()
+	}
 
 	ticker := time.NewTicker(c.scanInterval)
 	for range ticker.C {
+		if c.eventStream {
+			if err := c.refreshStats(); err != nil {
+				log.Printf("error refreshing stats: %v\n", err)
+			}
+			c.recheckContainers()
+			continue
+		}
 		if err := c.Refresh(); err != nil {
 			log.Printf("error refreshing: %v\n", err)
 		}
@@ -390,25 +1121,21 @@ func (c *Controller) Start() {
 }
 
 func (c *Controller) serveHome(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
 	tmplData := struct {
-		PageTitle    string
-		ShowCredit   bool
-		Groups       map[string][]string
-		Projects     map[string][]Container
-		Stats        Stats
-		HistDataCPU  []float64
-		HistDataTemp []float64
-		HistPeriod   time.Duration
+		PageTitle  string
+		ShowCredit bool
+		Groups     map[string][]string
+		Projects   map[string][]Container
+		Stats      Stats
 	}{
 		c.pageTitle,
 		c.showCredit,
-		c.lastGroups,
-		c.lastProjects,
+		cloneGroups(c.lastGroups),
+		cloneProjects(c.lastProjects),
 		c.lastStats,
-		c.histCPU,
-		c.histTemp,
-		c.scanInterval,
 	}
+	c.mu.Unlock()
 	for _, projects := range tmplData.Groups {
 		sort.Strings(projects)
 	}
@@ -426,6 +1153,210 @@ func (c *Controller) serveHome(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (c *Controller) serveChartJS(w http.ResponseWriter, r *http.Request) {
-	http.ServeContent(w, r, "chart.js", time.Unix(0, 0), bytes.NewReader(chartJS))
+// serveEvents streams state changes to the browser as Server-Sent Events, so
+// the page in serveHome can patch itself up instead of relying on the user
+// to refresh it.
+func (c *Controller) serveEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	events, unsubscribe := c.subscribeSSE()
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case ev := <-events:
+			data, err := json.Marshal(ev.Data)
+			if err != nil {
+				log.Printf("error marshalling sse event: %v\n", err)
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.Type, data)
+			flusher.Flush()
+		}
+	}
+}
+
+// Up reports whether a container should be considered up for monitoring
+// purposes, preferring its native health check, then its configured liveness
+// check, then falling back to the raw docker status string. It's exported
+// so the template can use it as the fallback case for containers with
+// neither a Health nor a Check set.
+func (c Container) Up() bool {
+	switch {
+	case c.Health != nil:
+		return c.Health.Status == "healthy"
+	case c.Check != nil:
+		return c.Check.OK()
+	default:
+		return strings.HasPrefix(c.Status, "up")
+	}
+}
+
+// class mirrors the green/yellow/red row colouring the template applies, for
+// use by code (like the SSE events) that can't run the template's own logic.
+func (c Container) class() string {
+	switch {
+	case c.Health != nil:
+		switch c.Health.Status {
+		case "healthy":
+			return "green"
+		case "starting":
+			return "yellow"
+		default:
+			return "red"
+		}
+	case c.Check != nil:
+		if c.Check.OK() {
+			return "green"
+		}
+		return "red"
+	default:
+		if strings.HasPrefix(c.Status, "up") {
+			return "green"
+		}
+		return "red"
+	}
+}
+
+// detail mirrors the status text the template renders in the last column,
+// for use by code that can't run the template's own logic.
+func (c Container) detail() string {
+	switch {
+	case c.Health != nil:
+		return fmt.Sprintf("%s (%d)", c.Health.Status, c.Health.FailingStreak)
+	case c.Check != nil:
+		return c.Check.Detail()
+	default:
+		return c.Status
+	}
+}
+
+// containerJSON is the over-the-wire representation of a Container sent to
+// /events clients, which patch the DOM directly from it instead of running
+// the html/template logic that Container.Check's methods feed on the server.
+type containerJSON struct {
+	Name   string `json:"name"`
+	Class  string `json:"class"`
+	Detail string `json:"detail"`
+	Link   string `json:"link"`
+}
+
+func newContainerJSON(c Container) containerJSON {
+	return containerJSON{
+		Name:   c.Name,
+		Class:  c.class(),
+		Detail: c.detail(),
+		Link:   c.Link,
+	}
+}
+
+func (c *Controller) serveMetrics(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	stats := c.lastStats
+	containers := cloneProjects(c.lastProjects)
+	c.mu.Unlock()
+	projects := make([]string, 0, len(containers))
+	for project := range containers {
+		projects = append(projects, project)
+	}
+	sort.Strings(projects)
+
+	buff := c.buffPool.Get()
+	defer c.buffPool.Put(buff)
+
+	fmt.Fprintf(buff, "# HELP compose_status_cpu_percent host cpu usage, percent\n")
+	fmt.Fprintf(buff, "# TYPE compose_status_cpu_percent gauge\n")
+	fmt.Fprintf(buff, "compose_status_cpu_percent %v\n", stats.CPU)
+
+	fmt.Fprintf(buff, "# HELP compose_status_cpu_temp_celsius host cpu temperature, celsius\n")
+	fmt.Fprintf(buff, "# TYPE compose_status_cpu_temp_celsius gauge\n")
+	fmt.Fprintf(buff, "compose_status_cpu_temp_celsius %v\n", stats.CPUTemp)
+
+	fmt.Fprintf(buff, "# HELP compose_status_mem_used_bytes host memory in use, bytes\n")
+	fmt.Fprintf(buff, "# TYPE compose_status_mem_used_bytes gauge\n")
+	fmt.Fprintf(buff, "compose_status_mem_used_bytes %d\n", stats.MemUsed)
+
+	fmt.Fprintf(buff, "# HELP compose_status_mem_total_bytes host memory total, bytes\n")
+	fmt.Fprintf(buff, "# TYPE compose_status_mem_total_bytes gauge\n")
+	fmt.Fprintf(buff, "compose_status_mem_total_bytes %d\n", stats.MemTotal)
+
+	fmt.Fprintf(buff, "# HELP compose_status_load host load average\n")
+	fmt.Fprintf(buff, "# TYPE compose_status_load gauge\n")
+	fmt.Fprintf(buff, "compose_status_load{window=\"1\"} %v\n", stats.Load1)
+	fmt.Fprintf(buff, "compose_status_load{window=\"5\"} %v\n", stats.Load5)
+	fmt.Fprintf(buff, "compose_status_load{window=\"15\"} %v\n", stats.Load15)
+
+	fmt.Fprintf(buff, "# HELP compose_status_uptime_seconds host uptime, seconds\n")
+	fmt.Fprintf(buff, "# TYPE compose_status_uptime_seconds gauge\n")
+	fmt.Fprintf(buff, "compose_status_uptime_seconds %v\n", stats.Uptime.Seconds())
+
+	fmt.Fprintf(buff, "# HELP compose_status_container_up whether a container is considered up\n")
+	fmt.Fprintf(buff, "# TYPE compose_status_container_up gauge\n")
+	for _, project := range projects {
+		for _, container := range containers[project] {
+			up := 0
+			if container.Up() {
+				up = 1
+			}
+			fmt.Fprintf(buff, "compose_status_container_up{project=%q,name=%q} %d\n", project, container.Name, up)
+		}
+	}
+
+	fmt.Fprintf(buff, "# HELP compose_status_container_check_duration_seconds duration of the last liveness check\n")
+	fmt.Fprintf(buff, "# TYPE compose_status_container_check_duration_seconds gauge\n")
+	for _, project := range projects {
+		for _, container := range containers[project] {
+			switch {
+			case container.Health != nil:
+				fmt.Fprintf(buff, "compose_status_container_check_duration_seconds{project=%q,name=%q} %v\n", project, container.Name, container.Health.Latency.Seconds())
+			case container.Check != nil:
+				fmt.Fprintf(buff, "compose_status_container_check_duration_seconds{project=%q,name=%q} %v\n", project, container.Name, container.Check.Latency().Seconds())
+			}
+		}
+	}
+
+	// compose_status_container_http_duration_seconds is check_duration_seconds
+	// under its original name, kept alongside it so dashboards built before
+	// the check type was generalised beyond HTTP don't silently break.
+	fmt.Fprintf(buff, "# HELP compose_status_container_http_duration_seconds duration of the last liveness check, deprecated alias of compose_status_container_check_duration_seconds\n")
+	fmt.Fprintf(buff, "# TYPE compose_status_container_http_duration_seconds gauge\n")
+	for _, project := range projects {
+		for _, container := range containers[project] {
+			switch {
+			case container.Health != nil:
+				fmt.Fprintf(buff, "compose_status_container_http_duration_seconds{project=%q,name=%q} %v\n", project, container.Name, container.Health.Latency.Seconds())
+			case container.Check != nil:
+				fmt.Fprintf(buff, "compose_status_container_http_duration_seconds{project=%q,name=%q} %v\n", project, container.Name, container.Check.Latency().Seconds())
+			}
+		}
+	}
+
+	fmt.Fprintf(buff, "# HELP compose_status_container_http_code status code of the last http check\n")
+	fmt.Fprintf(buff, "# TYPE compose_status_container_http_code gauge\n")
+	for _, project := range projects {
+		for _, container := range containers[project] {
+			httpCheck, ok := container.Check.(*HTTPCheck)
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(buff, "compose_status_container_http_code{project=%q,name=%q} %d\n", project, container.Name, httpCheck.code)
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if _, err := buff.WriteTo(w); err != nil {
+		log.Printf("error writing response buffer: %v\n", err)
+	}
 }
@@ -1,6 +1,9 @@
 package status
 
 import (
+	"html/template"
+	"io"
+	"reflect"
 	"testing"
 )
 
@@ -9,26 +12,61 @@ func TestHostFromLabel(t *testing.T) {
 		name  string
 		label string
 		exp   string
+		alts  []string
 	}{
-		{"v1 extra regex", "HostRegexp:{catchall:.*}", ""},
-		{"v1 extra regex", "HostRegexp:.*", ""},
-		{"v1 normal", "Host:what.it.do", "what.it.do"},
-		{"v1 normal", "Host:good.morning", "good.morning"},
-		{"v1 normal", "Host:good.morning;Path=/notifications/hub", "good.morning"},
-		{"v1 comma", "Host:what.it.do,howdy.partner", "what.it.do"},
-		{"v1 comma", "Host:what.it.do,howdy.partner,what", "what.it.do"},
-		{"v2 normal", "Host(`what.it.do`)", "what.it.do"},
-		{"v2 number", "Host(`mp3.mixtape.fam`)", "mp3.mixtape.fam"},
-		{"v2 operator", "Path(`/path`) || Host(`what.it.do`)", "what.it.do"},
-		{"v2 with hyphen", "Path(`/path`) || Host(`what-dev.it.do`)", "what-dev.it.do"},
-		{"empty", "", ""},
+		{"v1 extra regex", "HostRegexp:{catchall:.*}", "", nil},
+		{"v1 extra regex", "HostRegexp:.*", "", nil},
+		{"v1 normal", "Host:what.it.do", "what.it.do", nil},
+		{"v1 normal", "Host:good.morning", "good.morning", nil},
+		{"v1 normal", "Host:good.morning;Path=/notifications/hub", "good.morning", nil},
+		{"v1 comma", "Host:what.it.do,howdy.partner", "what.it.do", []string{"howdy.partner"}},
+		{"v1 comma", "Host:what.it.do,howdy.partner,what", "what.it.do", []string{"howdy.partner", "what"}},
+		{"v2 normal", "Host(`what.it.do`)", "what.it.do", nil},
+		{"v2 number", "Host(`mp3.mixtape.fam`)", "mp3.mixtape.fam", nil},
+		{"v2 operator", "Path(`/path`) || Host(`what.it.do`)", "what.it.do", nil},
+		{"v2 with hyphen", "Path(`/path`) || Host(`what-dev.it.do`)", "what-dev.it.do", nil},
+		{"v3 host sni", "HostSNI(`example.com`)", "example.com", nil},
+		{"v3 host header", "HostHeader(`example.com`)", "example.com", nil},
+		{"v3 multi host", "Host(`a.com`, `b.com`)", "a.com", []string{"b.com"}},
+		{"v3 combined", "Host(`a.com`) && PathPrefix(`/api`)", "a.com", nil},
+		{"v3 alternatives", "Host(`a.com`) || HostSNI(`b.com`)", "a.com", []string{"b.com"}},
+		{"v3 regex", "HostRegexp(`^.+\\.example\\.com$`)", "", nil},
+		{"empty", "", "", nil},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			actu := parseLabelHost(tc.label)
+			actu, alts := parseLabelHost(tc.label)
 			if actu != tc.exp {
 				t.Errorf("expected %q, got %q", tc.exp, actu)
 			}
+			if !reflect.DeepEqual(alts, tc.alts) {
+				t.Errorf("expected alts %v, got %v", tc.alts, alts)
+			}
 		})
 	}
 }
+
+// TestHomeTmplNoHealthOrCheck guards against the home page 500ing for the
+// most common container shape: one with neither a HEALTHCHECK nor a
+// xyz.senan.compose-status.check.* label, so Health and Check are both nil
+// and the template has to fall back to the raw Status string.
+func TestHomeTmplNoHealthOrCheck(t *testing.T) {
+	tmpl, err := template.New("").Funcs(funcMap).Parse(homeTmpl)
+	if err != nil {
+		t.Fatalf("parsing homeTmpl: %v", err)
+	}
+	data := struct {
+		PageTitle  string
+		ShowCredit bool
+		Groups     map[string][]string
+		Projects   map[string][]Container
+		Stats      Stats
+	}{
+		Projects: map[string][]Container{
+			"someproject": {{Name: "somecontainer", Status: "up 2 days"}},
+		},
+	}
+	if err := tmpl.Execute(io.Discard, data); err != nil {
+		t.Fatalf("executing homeTmpl against a container with nil Health and nil Check: %v", err)
+	}
+}